@@ -0,0 +1,40 @@
+package andamioscan
+
+import (
+	"sync"
+	"time"
+)
+
+type cacheEntry struct {
+	value    interface{}
+	expireAt time.Time
+}
+
+// ttlCache is a small in-memory cache with per-entry expiry, used so
+// concurrent HTMX polls for the same key don't keep hitting the upstream.
+type ttlCache struct {
+	mu  sync.Mutex
+	ttl time.Duration
+	m   map[string]cacheEntry
+}
+
+func newTTLCache(ttl time.Duration) *ttlCache {
+	return &ttlCache{ttl: ttl, m: make(map[string]cacheEntry)}
+}
+
+func (c *ttlCache) Get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.m[key]
+	if !ok || time.Now().After(entry.expireAt) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *ttlCache) Set(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.m[key] = cacheEntry{value: value, expireAt: time.Now().Add(c.ttl)}
+}
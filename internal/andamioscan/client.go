@@ -0,0 +1,207 @@
+// Package andamioscan is a client for the andamioscan Cardano blockchain
+// explorer API. It wraps the raw HTTP calls with context-aware deadlines,
+// bounded retries, response caching, and typed errors so callers don't have
+// to deal with raw transport failures or stampede the upstream on every
+// HTMX poll.
+package andamioscan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// Network selects which andamioscan deployment a Client talks to.
+type Network string
+
+const (
+	Preprod Network = "preprod"
+	Mainnet Network = "mainnet"
+)
+
+const (
+	preprodBaseURL = "https://preprod.andamioscan.andamio.space"
+	mainnetBaseURL = "https://andamioscan.andamio.space"
+
+	countsCacheTTL = 10 * time.Second
+	txCacheTTL     = 60 * time.Second
+
+	maxRetries = 3
+)
+
+// BaseURLForNetwork returns the known andamioscan base URL for n.
+func BaseURLForNetwork(n Network) string {
+	if n == Mainnet {
+		return mainnetBaseURL
+	}
+	return preprodBaseURL
+}
+
+// Client is an andamioscan API client with bounded retries and response
+// caching.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+
+	countsCache *ttlCache
+	txCache     *ttlCache
+	group       singleflight.Group
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient overrides the default http.Client, e.g. in tests.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) { c.httpClient = hc }
+}
+
+// WithCountsCacheTTL overrides the default TTL for the transaction-counts
+// cache (countsCacheTTL).
+func WithCountsCacheTTL(ttl time.Duration) Option {
+	return func(c *Client) { c.countsCache = newTTLCache(ttl) }
+}
+
+// WithTxCacheTTL overrides the default TTL for the confirmed-transaction
+// cache (txCacheTTL).
+func WithTxCacheTTL(ttl time.Duration) Option {
+	return func(c *Client) { c.txCache = newTTLCache(ttl) }
+}
+
+// NewClient builds a Client against baseURL (see BaseURLForNetwork for the
+// known andamioscan deployments).
+func NewClient(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:     baseURL,
+		httpClient:  &http.Client{Timeout: 10 * time.Second},
+		countsCache: newTTLCache(countsCacheTTL),
+		txCache:     newTTLCache(txCacheTTL),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// TransactionCounts fetches the aggregate transaction-type counters.
+func (c *Client) TransactionCounts(ctx context.Context) (TransactionCounts, error) {
+	const cacheKey = "transactions/count"
+	if v, ok := c.countsCache.Get(cacheKey); ok {
+		return v.(TransactionCounts), nil
+	}
+
+	v, err, _ := c.group.Do(cacheKey, func() (interface{}, error) {
+		var out TransactionCounts
+		if err := c.getJSON(ctx, "/v2/transactions/count", &out); err != nil {
+			return TransactionCounts{}, err
+		}
+		c.countsCache.Set(cacheKey, out)
+		return out, nil
+	})
+	if err != nil {
+		return TransactionCounts{}, err
+	}
+	return v.(TransactionCounts), nil
+}
+
+// Transaction fetches a single confirmed transaction by hash.
+func (c *Client) Transaction(ctx context.Context, hash string) (Transaction, error) {
+	cacheKey := "transactions/" + hash
+	if v, ok := c.txCache.Get(cacheKey); ok {
+		return v.(Transaction), nil
+	}
+
+	v, err, _ := c.group.Do(cacheKey, func() (interface{}, error) {
+		var out []Transaction
+		if err := c.getJSON(ctx, "/v2/transactions/"+hash, &out); err != nil {
+			return Transaction{}, err
+		}
+		if len(out) == 0 {
+			return Transaction{}, fmt.Errorf("transaction %s: %w", hash, ErrNotFound)
+		}
+		c.txCache.Set(cacheKey, out[0])
+		return out[0], nil
+	})
+	if err != nil {
+		return Transaction{}, err
+	}
+	return v.(Transaction), nil
+}
+
+// Address fetches an account's balance and activity summary.
+func (c *Client) Address(ctx context.Context, addr string) (Address, error) {
+	var out Address
+	if err := c.getJSON(ctx, "/v2/addresses/"+addr, &out); err != nil {
+		return Address{}, err
+	}
+	return out, nil
+}
+
+// Block fetches a confirmed block by height or hash.
+func (c *Client) Block(ctx context.Context, id string) (Block, error) {
+	var out Block
+	if err := c.getJSON(ctx, "/v2/blocks/"+id, &out); err != nil {
+		return Block{}, err
+	}
+	return out, nil
+}
+
+// getJSON performs a GET against path with bounded exponential-backoff
+// retries on 5xx responses and transport errors, decoding the JSON body into
+// out on success.
+func (c *Client) getJSON(ctx context.Context, path string, out interface{}) error {
+	var lastErr error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			backoff := time.Duration(math.Pow(2, float64(attempt-1))) * 100 * time.Millisecond
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return fmt.Errorf("%w: %v", ErrUpstreamUnavailable, ctx.Err())
+			}
+		}
+
+		err := c.doGet(ctx, path, out)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, ErrUpstreamUnavailable) {
+			return err
+		}
+		lastErr = err
+	}
+	return lastErr
+}
+
+func (c *Client) doGet(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUpstreamUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("%s: %w", path, ErrNotFound)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return fmt.Errorf("%w: status %d", ErrUpstreamUnavailable, resp.StatusCode)
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("%s: unexpected status %d", path, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("%s: %w: %v", path, ErrDecodeFailed, err)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package andamioscan
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestTransactionCounts_RetriesOn5xxThenSucceeds(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) <= 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"total": 7}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	counts, err := c.TransactionCounts(context.Background())
+	if err != nil {
+		t.Fatalf("TransactionCounts() error = %v, want nil", err)
+	}
+	if counts.Total != 7 {
+		t.Fatalf("Total = %d, want 7", counts.Total)
+	}
+	if got := atomic.LoadInt32(&hits); got != 3 {
+		t.Fatalf("upstream got %d requests, want 3 (2 failures + 1 success)", got)
+	}
+}
+
+func TestTransactionCounts_ExhaustsRetriesOn5xx(t *testing.T) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+	if _, err := c.TransactionCounts(context.Background()); err == nil {
+		t.Fatal("TransactionCounts() error = nil, want an error after a persistently failing upstream")
+	}
+	if want := int32(maxRetries + 1); atomic.LoadInt32(&hits) != want {
+		t.Fatalf("upstream got %d requests, want %d (1 initial + %d retries)", hits, want, maxRetries)
+	}
+}
+
+func TestTransactionCounts_SingleflightDedupesConcurrentCalls(t *testing.T) {
+	var hits int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		<-release // hold every request open until all callers have fired
+		w.Write([]byte(`{"total": 3}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL)
+
+	const callers = 10
+	var started int32
+	var wg sync.WaitGroup
+	wg.Add(callers)
+	for i := 0; i < callers; i++ {
+		go func() {
+			defer wg.Done()
+			atomic.AddInt32(&started, 1)
+			counts, err := c.TransactionCounts(context.Background())
+			if err != nil {
+				t.Errorf("TransactionCounts() error = %v, want nil", err)
+				return
+			}
+			if counts.Total != 3 {
+				t.Errorf("Total = %d, want 3", counts.Total)
+			}
+		}()
+	}
+
+	// Wait until every caller is in flight before letting the upstream
+	// respond, so they're genuinely racing into singleflight together
+	// rather than serializing through the cache one at a time.
+	for atomic.LoadInt32(&started) < callers {
+		runtime.Gosched()
+	}
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&hits); got != 1 {
+		t.Fatalf("upstream got %d requests, want 1 -- concurrent calls should collapse via singleflight", got)
+	}
+}
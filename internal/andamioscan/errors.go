@@ -0,0 +1,11 @@
+package andamioscan
+
+import "errors"
+
+// Sentinel errors distinguishing why a Client call failed. Callers should
+// use errors.Is against these rather than inspecting HTTP status codes.
+var (
+	ErrNotFound            = errors.New("andamioscan: not found")
+	ErrUpstreamUnavailable = errors.New("andamioscan: upstream unavailable")
+	ErrDecodeFailed        = errors.New("andamioscan: decode failed")
+)
@@ -0,0 +1,32 @@
+package andamioscan
+
+// TransactionCounts is the aggregate transaction-type counter returned by
+// GET /v2/transactions/count.
+type TransactionCounts struct {
+	Total           int `json:"total"`
+	MintAccessToken int `json:"mint_access_token"`
+	CreateCourse    int `json:"create_course"`
+}
+
+// Transaction is a single confirmed transaction.
+type Transaction struct {
+	TxHash      string   `json:"tx_hash"`
+	Types       []string `json:"types"`
+	SubmittedAt string   `json:"submitted_at"`
+}
+
+// Address is an account's balance and activity summary.
+type Address struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+	TxCount int    `json:"tx_count"`
+}
+
+// Block is a confirmed block summary.
+type Block struct {
+	Height    int    `json:"height"`
+	Hash      string `json:"hash"`
+	TxCount   int    `json:"tx_count"`
+	Size      int    `json:"size"`
+	Timestamp string `json:"timestamp"`
+}
@@ -0,0 +1,93 @@
+// Package config loads Rapid Rocket's runtime configuration from an
+// optional config.yaml and environment variables, so the binary can be
+// pointed at different Cardano networks and deployments without
+// recompiling.
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/nelsonksh/rapid-rocket/internal/andamioscan"
+)
+
+// Config is the fully resolved runtime configuration.
+type Config struct {
+	HTTPAddr           string        `yaml:"http_addr"`
+	AndamioscanBaseURL string        `yaml:"andamioscan_base_url"`
+	Network            string        `yaml:"network"` // "preprod" or "mainnet"
+	CacheTTL           time.Duration `yaml:"cache_ttl"`
+	EnableSSE          bool          `yaml:"enable_sse"`
+	RequestTimeout     time.Duration `yaml:"request_timeout"`
+}
+
+func defaults() Config {
+	return Config{
+		HTTPAddr:       ":8080",
+		Network:        string(andamioscan.Preprod),
+		CacheTTL:       10 * time.Second,
+		EnableSSE:      true,
+		RequestTimeout: 5 * time.Second,
+	}
+}
+
+// Load resolves Config from configPath (a YAML file, skipped if empty or
+// missing) and then environment variables, which take precedence over the
+// file. AndamioscanBaseURL defaults to the known URL for Network unless set
+// explicitly by either source.
+func Load(configPath string) (Config, error) {
+	cfg := defaults()
+
+	if configPath != "" {
+		data, err := os.ReadFile(configPath)
+		switch {
+		case err == nil:
+			if err := yaml.Unmarshal(data, &cfg); err != nil {
+				return Config{}, fmt.Errorf("parse %s: %w", configPath, err)
+			}
+		case os.IsNotExist(err):
+			// No config.yaml is fine; env vars and defaults still apply.
+		default:
+			return Config{}, fmt.Errorf("read %s: %w", configPath, err)
+		}
+	}
+
+	applyEnv(&cfg)
+
+	if cfg.AndamioscanBaseURL == "" {
+		cfg.AndamioscanBaseURL = andamioscan.BaseURLForNetwork(andamioscan.Network(cfg.Network))
+	}
+
+	return cfg, nil
+}
+
+func applyEnv(cfg *Config) {
+	if v := os.Getenv("HTTP_ADDR"); v != "" {
+		cfg.HTTPAddr = v
+	}
+	if v := os.Getenv("ANDAMIOSCAN_BASE_URL"); v != "" {
+		cfg.AndamioscanBaseURL = v
+	}
+	if v := os.Getenv("NETWORK"); v != "" {
+		cfg.Network = v
+	}
+	if v := os.Getenv("CACHE_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.CacheTTL = d
+		}
+	}
+	if v := os.Getenv("ENABLE_SSE"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			cfg.EnableSSE = b
+		}
+	}
+	if v := os.Getenv("REQUEST_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.RequestTimeout = d
+		}
+	}
+}
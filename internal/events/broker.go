@@ -0,0 +1,104 @@
+// Package events implements a small Server-Sent Events broker that fans out
+// published messages to subscribed browser clients, plus a Poller that
+// detects upstream changes and feeds the broker.
+package events
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const heartbeatInterval = 15 * time.Second
+
+// Broker fans out published messages to every subscribed client and serves
+// them as an SSE stream.
+type Broker struct {
+	mu          sync.Mutex
+	subscribers map[chan []byte]struct{}
+}
+
+// NewBroker creates an empty Broker.
+func NewBroker() *Broker {
+	return &Broker{subscribers: make(map[chan []byte]struct{})}
+}
+
+// Subscribe registers a new client channel and returns a func to remove it.
+func (b *Broker) Subscribe() (chan []byte, func()) {
+	ch := make(chan []byte, 8)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	unsubscribe := func() {
+		b.mu.Lock()
+		delete(b.subscribers, ch)
+		close(ch)
+		b.mu.Unlock()
+	}
+	return ch, unsubscribe
+}
+
+// Publish sends msg to every subscriber. A subscriber with a full buffer
+// drops the message rather than blocking the publisher.
+func (b *Broker) Publish(msg []byte) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers {
+		select {
+		case ch <- msg:
+		default:
+		}
+	}
+}
+
+// ServeHTTP streams messages published to the Broker as SSE events until
+// the client disconnects, sending a heartbeat comment periodically so
+// proxies don't time out the connection.
+func (b *Broker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ch, unsubscribe := b.Subscribe()
+	defer unsubscribe()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case msg := <-ch:
+			writeSSEData(w, msg)
+			flusher.Flush()
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+			flusher.Flush()
+		}
+	}
+}
+
+// writeSSEData writes msg as a spec-compliant SSE "data:" event. Per the SSE
+// spec every line of the payload needs its own "data:" prefix, which matters
+// once msg can be multi-line HTML (it used to only ever be single-line JSON):
+// writing "data: %s\n\n" verbatim would prefix only the first line and leave
+// the rest of the markup unframed.
+func writeSSEData(w http.ResponseWriter, msg []byte) {
+	for _, line := range bytes.Split(msg, []byte("\n")) {
+		fmt.Fprintf(w, "data: %s\n", line)
+	}
+	fmt.Fprint(w, "\n")
+}
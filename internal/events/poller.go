@@ -0,0 +1,49 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"time"
+)
+
+// FetchFunc retrieves the latest encoded snapshot of whatever a Poller is
+// watching, for comparison against the previous poll.
+type FetchFunc func(ctx context.Context) ([]byte, error)
+
+// Poller calls fetch on a fixed interval and publishes to a Broker whenever
+// the result changes, so subscribers only see real updates.
+type Poller struct {
+	broker   *Broker
+	fetch    FetchFunc
+	interval time.Duration
+}
+
+// NewPoller builds a Poller that calls fetch every interval and publishes
+// its result to broker when it differs from the previous poll.
+func NewPoller(broker *Broker, interval time.Duration, fetch FetchFunc) *Poller {
+	return &Poller{broker: broker, fetch: fetch, interval: interval}
+}
+
+// Run polls until ctx is done. It should be started in its own goroutine.
+func (p *Poller) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	var last []byte
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			data, err := p.fetch(ctx)
+			if err != nil {
+				continue
+			}
+			if bytes.Equal(data, last) {
+				continue
+			}
+			last = data
+			p.broker.Publish(data)
+		}
+	}
+}
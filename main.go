@@ -1,30 +1,111 @@
 package main
 
 import (
-	"encoding/json"
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"html/template"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"syscall"
+	"time"
+
+	"github.com/nelsonksh/rapid-rocket/internal/andamioscan"
+	"github.com/nelsonksh/rapid-rocket/internal/config"
+	"github.com/nelsonksh/rapid-rocket/internal/events"
+)
+
+const (
+	analyticsPollInterval    = 10 * time.Second
+	transactionsPollInterval = 10 * time.Second
 )
 
-// External API Models
-type TransactionCounts struct {
-	Total           int `json:"total"`
-	MintAccessToken int `json:"mint_access_token"`
-	CreateCourse    int `json:"create_course"`
+const txsPerPage = 25
+
+// Server owns the HTTP mux and the dependencies handlers need, wired from
+// Config so the module can be deployed against different Cardano networks
+// without recompiling.
+type Server struct {
+	cfg  config.Config
+	scan *andamioscan.Client
+	mux  *http.ServeMux
+
+	analyticsBroker    *events.Broker
+	transactionsBroker *events.Broker
 }
 
-type AnalyticsAPIResponse struct {
-	Count TransactionCounts `json:"count"`
+// NewServer builds a Server from cfg and registers its routes.
+func NewServer(cfg config.Config) *Server {
+	s := &Server{
+		cfg:  cfg,
+		// cfg.CacheTTL only tunes the counts cache; the confirmed-transaction
+		// cache keeps its own longer default (see txCacheTTL) since
+		// transactions don't change once confirmed.
+		scan: andamioscan.NewClient(cfg.AndamioscanBaseURL, andamioscan.WithCountsCacheTTL(cfg.CacheTTL)),
+		mux:  http.NewServeMux(),
+	}
+	s.routes()
+	return s
 }
 
-type TransactionAPIResponse struct {
-	TxHash      string   `json:"tx_hash"`
-	Types       []string `json:"types"`
-	SubmittedAt string   `json:"submitted_at"`
+func (s *Server) routes() {
+	wrap := func(h http.HandlerFunc) http.HandlerFunc {
+		return withTimeout(s.cfg.RequestTimeout, h)
+	}
+
+	s.mux.HandleFunc("/", wrap(indexHandler))
+	s.mux.HandleFunc("/docs", wrap(docsHandler))
+
+	// HTMX Fragment API
+	s.mux.HandleFunc("/api/analytics", wrap(s.analyticsHandler))
+	s.mux.HandleFunc("/api/transactions", wrap(transactionsHandler))
+	s.mux.HandleFunc("/api/contributions", wrap(contributionsHandler))
+	s.mux.HandleFunc("/search", wrap(s.searchHandler))
+
+	// Explorer pages
+	s.mux.HandleFunc("/tx/", wrap(s.txHandler))
+	s.mux.HandleFunc("/address/", wrap(s.addressHandler))
+	s.mux.HandleFunc("/block/", wrap(s.blockHandler))
+
+	if s.cfg.EnableSSE {
+		// SSE streams are long-lived by design, so they're deliberately not
+		// wrapped with the per-request deadline.
+		s.analyticsBroker = events.NewBroker()
+		s.mux.Handle("/events/analytics", s.analyticsBroker)
+
+		s.transactionsBroker = events.NewBroker()
+		s.mux.Handle("/events/transactions", s.transactionsBroker)
+	}
+
+	// Static files
+	s.mux.Handle("/assets/", http.StripPrefix("/assets/", http.FileServer(http.Dir("assets"))))
+}
+
+// withTimeout derives a bounded context.Context for each request, e.g. so an
+// HTMX fragment request can't block indefinitely when the andamioscan
+// upstream stalls.
+func withTimeout(timeout time.Duration, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		h(w, r.WithContext(ctx))
+	}
+}
+
+// startPollers launches the background SSE pollers. It is a no-op when SSE
+// is disabled. Callers should cancel ctx on shutdown.
+func (s *Server) startPollers(ctx context.Context) {
+	if !s.cfg.EnableSSE {
+		return
+	}
+	go events.NewPoller(s.analyticsBroker, analyticsPollInterval, s.fetchAnalyticsSnapshot).Run(ctx)
+	go events.NewPoller(s.transactionsBroker, transactionsPollInterval, fetchTransactionsSnapshot).Run(ctx)
 }
 
 // Data Models
@@ -62,34 +143,171 @@ type SearchResult struct {
 	Link     string
 }
 
-func main() {
-	// Router
-	mux := http.NewServeMux()
+type TransactionDetail struct {
+	Hash        string
+	Types       []string
+	SubmittedAt string
+}
 
-	// Routes
-	mux.HandleFunc("/", indexHandler)
-	mux.HandleFunc("/docs", docsHandler)
+type AddressDetail struct {
+	Address string
+	Balance string
+	TxCount int
+	Txs     []Transaction
+	Pager   Pager
+}
 
-	// HTMX Fragment API
-	mux.HandleFunc("/api/analytics", analyticsHandler)
-	mux.HandleFunc("/api/transactions", transactionsHandler)
-	mux.HandleFunc("/api/contributions", contributionsHandler)
-	mux.HandleFunc("/search", searchHandler)
+type BlockDetail struct {
+	Height    int
+	Hash      string
+	TxCount   int
+	Size      string
+	Timestamp string
+	Txs       []Transaction
+	Pager     Pager
+}
 
-	// Static files
-	mux.Handle("/assets/", http.StripPrefix("/assets/", http.FileServer(http.Dir("assets"))))
+// Pager produces prev/next/first/last links for an HTMX-paginated list.
+// Base is the path the page links are built against, e.g. "/address/addr_001".
+type Pager struct {
+	Base       string
+	Page       int
+	TotalPages int
+}
+
+// NewPager clamps page into [1, totalPages] given totalItems at perPage per page.
+func NewPager(base string, page, totalItems, perPage int) Pager {
+	totalPages := (totalItems + perPage - 1) / perPage
+	if totalPages < 1 {
+		totalPages = 1
+	}
+	if page < 1 {
+		page = 1
+	}
+	if page > totalPages {
+		page = totalPages
+	}
+	return Pager{Base: base, Page: page, TotalPages: totalPages}
+}
+
+func (p Pager) HasPrev() bool  { return p.Page > 1 }
+func (p Pager) HasNext() bool  { return p.Page < p.TotalPages }
+func (p Pager) PrevPage() int  { return p.Page - 1 }
+func (p Pager) NextPage() int  { return p.Page + 1 }
+func (p Pager) FirstPage() int { return 1 }
+func (p Pager) LastPage() int  { return p.TotalPages }
+
+// Link builds the HTMX fragment URL for the given page number.
+func (p Pager) Link(page int) string {
+	return fmt.Sprintf("%s?page=%d", p.Base, page)
+}
+
+// APIError is returned by handlers so writeError can render it consistently
+// as either a full error or an HTMX fragment.
+type APIError struct {
+	Text   string // message to show when Public is true
+	Public bool   // whether Text is safe to expose to the browser
+	Status int
+	Err    error // underlying error, always logged
+}
+
+func (e *APIError) Error() string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return e.Text
+}
 
-	// Server config
-	addr := ":8080"
-	log.Printf("🚀 Server starting on http://localhost%s", addr)
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// NewAPIError builds an APIError that writeError can render. Pass the
+// underlying err for logging even when Text is a generic public message.
+func NewAPIError(status int, text string, public bool, err error) *APIError {
+	return &APIError{Text: text, Public: public, Status: status, Err: err}
+}
+
+var errorTmpl = template.Must(template.ParseFiles(filepath.Join("views", "error.html")))
+
+// writeError renders err as an HTMX error fragment. Public errors show their
+// own text; non-public errors show a generic message while the real error is
+// logged with request context.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		apiErr = NewAPIError(http.StatusInternalServerError, "Something went wrong", false, err)
+	}
+
+	text := apiErr.Text
+	if !apiErr.Public {
+		log.Printf("%s %s: %v", r.Method, r.URL.Path, apiErr.Err)
+		text = "Something went wrong. Please try again."
+	}
+
+	w.WriteHeader(apiErr.Status)
+	data := struct{ Text string }{Text: text}
+	if err := errorTmpl.ExecuteTemplate(w, "error", data); err != nil {
+		log.Printf("Template execution error: %v", err)
+	}
+}
+
+// apiErrorFor maps an andamioscan client error to the APIError writeError
+// renders, choosing a public message for the failure modes users can
+// meaningfully act on.
+func apiErrorFor(err error) *APIError {
+	switch {
+	case errors.Is(err, andamioscan.ErrNotFound):
+		return NewAPIError(http.StatusNotFound, "Not found", true, err)
+	case errors.Is(err, andamioscan.ErrUpstreamUnavailable):
+		return NewAPIError(http.StatusBadGateway, "Upstream unavailable, please try again", true, err)
+	default:
+		return NewAPIError(http.StatusInternalServerError, "Failed to fetch data", false, err)
+	}
+}
+
+// shutdownTimeout bounds how long in-flight requests and SSE streams get to
+// drain once a shutdown signal arrives.
+const shutdownTimeout = 10 * time.Second
+
+func main() {
+	cfg, err := config.Load("config.yaml")
+	if err != nil {
+		log.Fatalf("load config: %v", err)
+	}
+
+	s := NewServer(cfg)
+
+	pollCtx, stopPolling := context.WithCancel(context.Background())
+	s.startPollers(pollCtx)
+
+	log.Printf("🚀 Server starting on http://localhost%s (network: %s)", cfg.HTTPAddr, cfg.Network)
 
 	server := &http.Server{
-		Addr:    addr,
-		Handler: mux,
+		Addr:         cfg.HTTPAddr,
+		Handler:      s.mux,
+		ReadTimeout:  15 * time.Second,
+		WriteTimeout: 15 * time.Second,
+		IdleTimeout:  60 * time.Second,
 	}
 
-	if err := server.ListenAndServe(); err != nil {
-		log.Fatal(err)
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("listen: %v", err)
+		}
+	}()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	<-sigCh
+
+	log.Print("shutting down...")
+	stopPolling()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("shutdown error: %v", err)
 	}
 }
 
@@ -119,43 +337,92 @@ func docsHandler(w http.ResponseWriter, r *http.Request) {
 	http.ServeFile(w, r, tmplPath)
 }
 
-func analyticsHandler(w http.ResponseWriter, r *http.Request) {
-	// 1. Fetch from Real API
-	resp, err := http.Get("https://preprod.andamioscan.andamio.space/v2/transactions/count")
-	if err != nil {
-		log.Printf("API fetch error: %v", err)
-		http.Error(w, "Failed to fetch data", http.StatusBadGateway)
-		return
+// renderPage executes tmpl as a full page wrapped in the shared layout for a
+// direct browser navigation, or as the bare "content" block when htmx made
+// the request (e.g. a pagination link click), so the response matches
+// whatever the triggering element's hx-swap expects instead of a full
+// document.
+func renderPage(w http.ResponseWriter, r *http.Request, tmpl *template.Template, data interface{}) error {
+	name := "layout"
+	if r.Header.Get("HX-Request") == "true" {
+		name = "content"
 	}
-	defer resp.Body.Close()
+	return tmpl.ExecuteTemplate(w, name, data)
+}
 
-	// 2. Decode JSON
-	var apiData AnalyticsAPIResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiData); err != nil {
-		log.Printf("JSON decode error: %v", err)
-		http.Error(w, "Failed to parse data", http.StatusInternalServerError)
-		return
+// renderFragment executes the named views/ template and returns the
+// resulting HTML, for anything that needs the bytes rather than a
+// ResponseWriter to stream to — namely the SSE snapshot functions below,
+// which must publish the same markup the HTMX fragment handlers render so
+// that htmx-ext-sse's innerHTML swap has HTML to swap in, not raw JSON.
+func renderFragment(name string, data interface{}) ([]byte, error) {
+	tmpl, err := template.ParseFiles(filepath.Join("views", name))
+	if err != nil {
+		return nil, err
 	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
 
-	// 3. Map to View Model
+// analyticsData maps a TransactionCounts response onto the Analytics view
+// model shared by analyticsHandler and fetchAnalyticsSnapshot, so the page
+// and the live SSE updates never drift apart.
+func analyticsData(counts andamioscan.TransactionCounts) Analytics {
 	// We only map the fields provided by the API: Total, MintAccessToken (Users), CreateCourse (Courses)
 	// Consistently maintain Mock or Placeholder values for missing data to avoid empty cards
-	data := Analytics{
-		TotalTransactions: apiData.Count.Total,
-		ActiveAddresses:   apiData.Count.MintAccessToken, // Mapping MintAccessToken to Users
-		TotalBlocks:       8945234,                       // Mock (Missing in API)
-		NetworkLoad:       78,                            // Mock (Missing in API)
-		AvgBlockTime:      20,                            // Mock (Missing in API)
-		TotalValue:        "45.2B ADA",                   // Mock (Missing in API)
-		CourseCount:       apiData.Count.CreateCourse,    // Mapping CreateCourse
-		ProjectCount:      8,                             // Mock (Missing in API)
+	return Analytics{
+		TotalTransactions: counts.Total,
+		ActiveAddresses:   counts.MintAccessToken, // Mapping MintAccessToken to Users
+		TotalBlocks:       8945234,                // Mock (Missing in API)
+		NetworkLoad:       78,                     // Mock (Missing in API)
+		AvgBlockTime:      20,                     // Mock (Missing in API)
+		TotalValue:        "45.2B ADA",            // Mock (Missing in API)
+		CourseCount:       counts.CreateCourse,    // Mapping CreateCourse
+		ProjectCount:      8,                      // Mock (Missing in API)
+	}
+}
+
+// fetchAnalyticsSnapshot renders the current transaction counts through the
+// same analytics.html template analyticsHandler serves, for the analytics
+// Poller to diff against its previous poll.
+func (s *Server) fetchAnalyticsSnapshot(ctx context.Context) ([]byte, error) {
+	counts, err := s.scan.TransactionCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return renderFragment("analytics.html", analyticsData(counts))
+}
+
+// fetchTransactionsSnapshot renders the latest transaction feed through the
+// same transactions.html template transactionsHandler serves, for the
+// transactions Poller to diff against its previous poll.
+//
+// andamioscan has no live transaction-feed endpoint yet, so this is still
+// mockTransactions underneath (see liveTransactions), but it rotates a
+// larger mock pool against the real clock so consecutive polls actually
+// differ — otherwise the feed never changes, Poller.Run's bytes.Equal dedup
+// never sees a diff, and the broker silently stops publishing after the
+// first poll.
+func fetchTransactionsSnapshot(ctx context.Context) ([]byte, error) {
+	return renderFragment("transactions.html", liveTransactions(time.Now()))
+}
+
+func (s *Server) analyticsHandler(w http.ResponseWriter, r *http.Request) {
+	counts, err := s.scan.TransactionCounts(r.Context())
+	if err != nil {
+		writeError(w, r, apiErrorFor(err))
+		return
 	}
 
+	data := analyticsData(counts)
+
 	tmplPath := filepath.Join("views", "analytics.html")
 	tmpl, err := template.ParseFiles(tmplPath)
 	if err != nil {
-		log.Printf("Template error: %v", err)
-		http.Error(w, "Could not load template", http.StatusInternalServerError)
+		writeError(w, r, NewAPIError(http.StatusInternalServerError, "Could not load template", false, err))
 		return
 	}
 
@@ -165,18 +432,12 @@ func analyticsHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 func transactionsHandler(w http.ResponseWriter, r *http.Request) {
-	// Mock Transactions
-	txs := []Transaction{
-		{Hash: "8a9b0c1d...", Timestamp: "5 minutes ago", Amount: "1,250.50 ADA", Types: []string{"Payment", "Fee"}},
-		{Hash: "7z8a9b0c...", Timestamp: "8 minutes ago", Amount: "450.00 ADA", Types: []string{"Payment"}},
-		{Hash: "6y7z8a9b...", Timestamp: "12 minutes ago", Amount: "2,100.25 ADA", Types: []string{"Stake"}},
-	}
+	txs := liveTransactions(time.Now())
 
 	tmplPath := filepath.Join("views", "transactions.html")
 	tmpl, err := template.ParseFiles(tmplPath)
 	if err != nil {
-		log.Printf("Template error: %v", err)
-		http.Error(w, "Could not load template", http.StatusInternalServerError)
+		writeError(w, r, NewAPIError(http.StatusInternalServerError, "Could not load template", false, err))
 		return
 	}
 
@@ -196,8 +457,7 @@ func contributionsHandler(w http.ResponseWriter, r *http.Request) {
 	tmplPath := filepath.Join("views", "contributions.html")
 	tmpl, err := template.ParseFiles(tmplPath)
 	if err != nil {
-		log.Printf("Template error: %v", err)
-		http.Error(w, "Could not load template", http.StatusInternalServerError)
+		writeError(w, r, NewAPIError(http.StatusInternalServerError, "Could not load template", false, err))
 		return
 	}
 
@@ -206,7 +466,7 @@ func contributionsHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func searchHandler(w http.ResponseWriter, r *http.Request) {
+func (s *Server) searchHandler(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query().Get("q")
 	if query == "" {
 		w.Write([]byte(""))
@@ -218,22 +478,15 @@ func searchHandler(w http.ResponseWriter, r *http.Request) {
 
 	// 1. Transaction Search (Real API)
 	if strings.HasPrefix(qLower, "tx_") || len(query) == 64 {
-		url := fmt.Sprintf("https://preprod.andamioscan.andamio.space/v2/transactions/%s", query)
-		resp, err := http.Get(url)
-		if err == nil && resp.StatusCode == http.StatusOK {
-			defer resp.Body.Close()
-			var txRes []TransactionAPIResponse
-			if err := json.NewDecoder(resp.Body).Decode(&txRes); err == nil && len(txRes) > 0 {
-				tx := txRes[0]
-				results = append(results, SearchResult{
-					Type:     "transaction",
-					ID:       tx.TxHash,
-					Title:    "Transaction",
-					Subtitle: tx.TxHash,
-					Details:  fmt.Sprintf("%v • %s", tx.Types, tx.SubmittedAt),
-					Link:     "#", // Link to details page when implemented
-				})
-			}
+		if tx, err := s.scan.Transaction(r.Context(), query); err == nil {
+			results = append(results, SearchResult{
+				Type:     "transaction",
+				ID:       tx.TxHash,
+				Title:    "Transaction",
+				Subtitle: tx.TxHash,
+				Details:  fmt.Sprintf("%v • %s", tx.Types, tx.SubmittedAt),
+				Link:     fmt.Sprintf("/tx/%s", tx.TxHash),
+			})
 		}
 	} else if strings.HasPrefix(qLower, "addr") || len(query) > 50 {
 		results = append(results, SearchResult{
@@ -242,7 +495,7 @@ func searchHandler(w http.ResponseWriter, r *http.Request) {
 			Title:    "Address",
 			Subtitle: query,
 			Details:  "Balance: 125,450.75 ADA • 342 transactions",
-			Link:     "#",
+			Link:     "/address/addr_001",
 		})
 	} else {
 		// Generic fallback or numeric check
@@ -252,7 +505,7 @@ func searchHandler(w http.ResponseWriter, r *http.Request) {
 			Title:    "Block",
 			Subtitle: "#8945234",
 			Details:  "245 transactions • 64.5 KB",
-			Link:     "#",
+			Link:     "/block/block_sample",
 		})
 	}
 
@@ -269,8 +522,7 @@ func searchHandler(w http.ResponseWriter, r *http.Request) {
 	tmplPath := filepath.Join("views", "search.html")
 	tmpl, err := template.ParseFiles(tmplPath)
 	if err != nil {
-		log.Printf("Template error: %v", err)
-		http.Error(w, "Could not load template", http.StatusInternalServerError)
+		writeError(w, r, NewAPIError(http.StatusInternalServerError, "Could not load template", false, err))
 		return
 	}
 
@@ -278,3 +530,185 @@ func searchHandler(w http.ResponseWriter, r *http.Request) {
 		log.Printf("Template execution error: %v", err)
 	}
 }
+
+func (s *Server) txHandler(w http.ResponseWriter, r *http.Request) {
+	hash, ok := pathSuffix("/tx/", r)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	tx, err := s.scan.Transaction(r.Context(), hash)
+	if err != nil {
+		writeError(w, r, apiErrorFor(err))
+		return
+	}
+
+	data := TransactionDetail{
+		Hash:        tx.TxHash,
+		Types:       tx.Types,
+		SubmittedAt: tx.SubmittedAt,
+	}
+
+	tmplPath := filepath.Join("views", "tx.html")
+	tmpl, err := template.ParseFiles(tmplPath, filepath.Join("views", "pagination.html"), filepath.Join("views", "layout.html"))
+	if err != nil {
+		writeError(w, r, NewAPIError(http.StatusInternalServerError, "Could not load template", false, err))
+		return
+	}
+
+	if err := renderPage(w, r, tmpl, data); err != nil {
+		log.Printf("Template execution error: %v", err)
+	}
+}
+
+func (s *Server) addressHandler(w http.ResponseWriter, r *http.Request) {
+	addr, ok := pathSuffix("/address/", r)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	page := pageFromQuery(r)
+
+	addrData, err := s.scan.Address(r.Context(), addr)
+	if err != nil {
+		writeError(w, r, apiErrorFor(err))
+		return
+	}
+
+	// andamioscan doesn't expose a paginated transaction list for an address
+	// yet, so Txs is still placeholder rows (see views/address.html) even
+	// though TxCount above is addrData's real count.
+	all := mockTransactions(60)
+	pager := NewPager(fmt.Sprintf("/address/%s", addr), page, len(all), txsPerPage)
+
+	data := AddressDetail{
+		Address: addrData.Address,
+		Balance: addrData.Balance,
+		TxCount: addrData.TxCount,
+		Txs:     paginateTxs(all, pager.Page),
+		Pager:   pager,
+	}
+
+	tmplPath := filepath.Join("views", "address.html")
+	tmpl, err := template.ParseFiles(tmplPath, filepath.Join("views", "pagination.html"), filepath.Join("views", "layout.html"))
+	if err != nil {
+		writeError(w, r, NewAPIError(http.StatusInternalServerError, "Could not load template", false, err))
+		return
+	}
+
+	if err := renderPage(w, r, tmpl, data); err != nil {
+		log.Printf("Template execution error: %v", err)
+	}
+}
+
+func (s *Server) blockHandler(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathSuffix("/block/", r)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	page := pageFromQuery(r)
+
+	block, err := s.scan.Block(r.Context(), id)
+	if err != nil {
+		writeError(w, r, apiErrorFor(err))
+		return
+	}
+
+	// andamioscan doesn't expose a paginated transaction list for a block
+	// yet, so Txs is still placeholder rows (see views/block.html) even
+	// though TxCount above is block's real count.
+	all := mockTransactions(45)
+	pager := NewPager(fmt.Sprintf("/block/%s", id), page, len(all), txsPerPage)
+
+	data := BlockDetail{
+		Height:    block.Height,
+		Hash:      block.Hash,
+		TxCount:   block.TxCount,
+		Size:      fmt.Sprintf("%.1f KB", float64(block.Size)/1024),
+		Timestamp: block.Timestamp,
+		Txs:       paginateTxs(all, pager.Page),
+		Pager:     pager,
+	}
+
+	tmplPath := filepath.Join("views", "block.html")
+	tmpl, err := template.ParseFiles(tmplPath, filepath.Join("views", "pagination.html"), filepath.Join("views", "layout.html"))
+	if err != nil {
+		writeError(w, r, NewAPIError(http.StatusInternalServerError, "Could not load template", false, err))
+		return
+	}
+
+	if err := renderPage(w, r, tmpl, data); err != nil {
+		log.Printf("Template execution error: %v", err)
+	}
+}
+
+// pathSuffix returns the single path segment following prefix (e.g. the
+// hash in "/tx/deadbeef"), or ("", false) if the request path doesn't have
+// exactly one non-empty segment there. The toolchain this module targets
+// predates http.ServeMux's {wildcard} patterns, so routes are registered on
+// the prefix and the remainder is parsed here.
+func pathSuffix(prefix string, r *http.Request) (string, bool) {
+	rest := strings.TrimPrefix(r.URL.Path, prefix)
+	if rest == "" || rest == r.URL.Path || strings.Contains(rest, "/") {
+		return "", false
+	}
+	return rest, true
+}
+
+// pageFromQuery reads ?page=N, defaulting to 1 for missing or invalid values.
+func pageFromQuery(r *http.Request) int {
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		return 1
+	}
+	return page
+}
+
+// paginateTxs slices all into the requested page of size txsPerPage. page
+// must already be clamped into [1, totalPages] (see NewPager) — callers
+// that slice with the raw, unclamped query page would render a mismatched
+// page number and row set for out-of-range input.
+func paginateTxs(all []Transaction, page int) []Transaction {
+	total := len(all)
+	start := (page - 1) * txsPerPage
+	if start > total {
+		start = total
+	}
+	end := start + txsPerPage
+	if end > total {
+		end = total
+	}
+	return all[start:end]
+}
+
+// liveTransactions returns the 3 mock transactions shown as the "live" feed
+// on the index page. It draws a rotating window out of a larger mock pool,
+// keyed off now, so the feed actually changes from one poll to the next
+// instead of forever echoing the same 3 entries.
+func liveTransactions(now time.Time) []Transaction {
+	const poolSize = 9
+	pool := mockTransactions(poolSize)
+	offset := int(now.Unix()/10) % poolSize
+	window := make([]Transaction, 3)
+	for i := range window {
+		window[i] = pool[(offset+i)%poolSize]
+	}
+	return window
+}
+
+// mockTransactions generates n placeholder transactions for explorer pages
+// until the andamioscan client exposes real paginated history.
+func mockTransactions(n int) []Transaction {
+	txs := make([]Transaction, n)
+	for i := range txs {
+		txs[i] = Transaction{
+			Hash:      fmt.Sprintf("mock_tx_%04d", i),
+			Timestamp: fmt.Sprintf("%d minutes ago", (i+1)*3),
+			Amount:    fmt.Sprintf("%d.00 ADA", (i+1)*100),
+			Types:     []string{"Payment"},
+		}
+	}
+	return txs
+}
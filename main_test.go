@@ -0,0 +1,66 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nelsonksh/rapid-rocket/internal/config"
+)
+
+func TestAnalyticsHandlerRespectsRequestTimeout(t *testing.T) {
+	slowUpstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(2 * time.Second)
+	}))
+	defer slowUpstream.Close()
+
+	cfg := config.Config{
+		AndamioscanBaseURL: slowUpstream.URL,
+		RequestTimeout:     100 * time.Millisecond,
+		CacheTTL:           time.Second,
+	}
+	s := NewServer(cfg)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/analytics", nil)
+	rec := httptest.NewRecorder()
+
+	start := time.Now()
+	s.mux.ServeHTTP(rec, req)
+	elapsed := time.Since(start)
+
+	if elapsed > time.Second {
+		t.Fatalf("handler took %s, want it to return well within the 2s upstream stall", elapsed)
+	}
+	if rec.Code == http.StatusOK {
+		t.Fatalf("expected a non-200 status from a stalled upstream, got %d", rec.Code)
+	}
+}
+
+func TestTxHandlerRespectsRequestTimeout(t *testing.T) {
+	slowUpstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(2 * time.Second)
+	}))
+	defer slowUpstream.Close()
+
+	cfg := config.Config{
+		AndamioscanBaseURL: slowUpstream.URL,
+		RequestTimeout:     100 * time.Millisecond,
+		CacheTTL:           time.Second,
+	}
+	s := NewServer(cfg)
+
+	req := httptest.NewRequest(http.MethodGet, "/tx/deadbeef", nil)
+	rec := httptest.NewRecorder()
+
+	start := time.Now()
+	s.mux.ServeHTTP(rec, req)
+	elapsed := time.Since(start)
+
+	if elapsed > time.Second {
+		t.Fatalf("handler took %s, want it to return well within the 2s upstream stall", elapsed)
+	}
+	if rec.Code == http.StatusOK {
+		t.Fatalf("expected a non-200 status from a stalled upstream, got %d", rec.Code)
+	}
+}